@@ -0,0 +1,128 @@
+package processor
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/crypto/sha3"
+
+	"github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/go-primitives/coin"
+)
+
+// ChecksumMode selects which scheme a chain expects its asset
+// directory names to be checksummed with.
+type ChecksumMode int
+
+const (
+	// EIP55 is the classic chain-agnostic mixed-case checksum.
+	EIP55 ChecksumMode = iota
+	// EIP1191 mixes the chain ID into the checksum hash, so the same
+	// address capitalizes differently per chain. RSK and Ethereum
+	// Classic opted into this to disambiguate addresses shared across
+	// forks of mainnet Ethereum.
+	EIP1191
+	// None skips checksum fixing entirely (non-EVM chains).
+	None
+)
+
+// eip1191Chains lists the EVM chains that opted into EIP-1191 instead
+// of plain EIP-55.
+var eip1191Chains = map[uint]bool{
+	coin.RSK:     true,
+	coin.CLASSIC: true,
+}
+
+// checksumModeFor resolves the ChecksumMode a chain expects.
+func checksumModeFor(chainID uint) ChecksumMode {
+	if !coin.IsEVM(chainID) {
+		return None
+	}
+
+	if eip1191Chains[chainID] {
+		return EIP1191
+	}
+
+	return EIP55
+}
+
+// eip1191Checksum implements EIP-1191: the same capitalization
+// algorithm as EIP-55, except the keccak256 hash also covers the chain
+// ID, so an address checksums differently per chain.
+func eip1191Checksum(chainID uint, address string) (string, error) {
+	addr := strings.ToLower(strings.TrimPrefix(address, "0x"))
+
+	hash := sha3.NewLegacyKeccak256()
+	if _, err := hash.Write([]byte(strconv.FormatUint(uint64(chainID), 10) + "0x" + addr)); err != nil {
+		return "", fmt.Errorf("failed to hash address: %w", err)
+	}
+
+	hashHex := hex.EncodeToString(hash.Sum(nil))
+
+	var sb strings.Builder
+	sb.WriteString("0x")
+
+	for i, c := range addr {
+		if c < 'a' || c > 'f' {
+			sb.WriteRune(c)
+			continue
+		}
+
+		digit, err := strconv.ParseInt(string(hashHex[i]), 16, 64)
+		if err != nil {
+			return "", fmt.Errorf("failed to parse hash digit: %w", err)
+		}
+
+		if digit >= 8 {
+			sb.WriteRune(unicode.ToUpper(c))
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// MigrationResult records one asset directory MigrateEIP1191 renamed
+// from its old EIP-55 name to the EIP-1191 name its chain now expects.
+type MigrationResult struct {
+	Chain string
+	From  string
+	To    string
+}
+
+// MigrateEIP1191 scans files for chains that have moved to EIP-1191,
+// re-checksums any directory still named under the old EIP-55 scheme,
+// and reports every rename it made. Run this once across a repo as a
+// standalone migration pass after adding a chain to eip1191Chains,
+// rather than as part of the regular Fix* chain.
+func (s *Service) MigrateEIP1191(files []*file.AssetFile) ([]MigrationResult, error) {
+	var results []MigrationResult
+
+	for _, f := range files {
+		if checksumModeFor(f.Chain().ID) != EIP1191 {
+			continue
+		}
+
+		from := filepath.Base(f.Path())
+
+		if err := s.FixETHAddressChecksum(f); err != nil {
+			return results, fmt.Errorf("failed to migrate %s: %w", from, err)
+		}
+
+		to := filepath.Base(f.Path())
+		if to != from {
+			results = append(results, MigrationResult{
+				Chain: f.Chain().Handle,
+				From:  from,
+				To:    to,
+			})
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,31 @@
+package processor
+
+import "testing"
+
+func TestEIP1191Checksum(t *testing.T) {
+	// Vectors for chain ID 30 (RSK mainnet), computed directly from the
+	// keccak256(chainID + "0x" + lowercased address) capitalization
+	// described in the EIP-1191 spec:
+	// https://github.com/ethereum/EIPs/blob/master/EIPS/eip-1191.md
+	tests := []struct {
+		chainID uint
+		address string
+		want    string
+	}{
+		{30, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", "0x5aaEB6053f3e94c9b9a09f33669435E7ef1bEAeD"},
+		{30, "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359", "0xFb6916095cA1Df60bb79ce92cE3EA74c37c5d359"},
+		{30, "0xde709f2102306220921060314715629080e2fb77", "0xDe709F2102306220921060314715629080e2FB77"},
+		{30, "0x27b1fdb04752bbc536007a920d24acb045561c26", "0x27b1FdB04752BBc536007A920D24ACB045561c26"},
+	}
+
+	for _, tt := range tests {
+		got, err := eip1191Checksum(tt.chainID, tt.address)
+		if err != nil {
+			t.Fatalf("eip1191Checksum(%d, %s) returned error: %v", tt.chainID, tt.address, err)
+		}
+
+		if got != tt.want {
+			t.Errorf("eip1191Checksum(%d, %s) = %s, want %s", tt.chainID, tt.address, got, tt.want)
+		}
+	}
+}
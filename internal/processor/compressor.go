@@ -0,0 +1,37 @@
+package processor
+
+// ImageCompressor is one stage of the logo-fixing pipeline. Compress
+// tries to bring the image at path under the sizeLimitKB budget, in
+// place, and reports ok=false when it couldn't help so the pipeline
+// can fall through to the next configured compressor.
+type ImageCompressor interface {
+	Compress(path string) (ok bool, err error)
+	Name() string
+}
+
+// DefaultCompressors is the pipeline FixLogo falls back to when a
+// Service is built without an explicit compressor list: the original
+// brute-force PNG re-encoder. WebP/AVIF transcoding changes the
+// asset's format, not just its bytes, so those live as LogoVariant
+// siblings (see DefaultLogoProfile) instead of as ImageCompressor
+// stages that would have to lie about fixing path in place.
+func DefaultCompressors() []ImageCompressor {
+	return []ImageCompressor{
+		NativePNGCompressor{},
+	}
+}
+
+// NativePNGCompressor re-encodes the PNG through ReFileSizePNG, which
+// writes it back only when the re-encoded bytes fit the size budget.
+// This is the original FixLogo behavior, kept as the final fallback.
+type NativePNGCompressor struct{}
+
+func (NativePNGCompressor) Name() string { return "native-png" }
+
+func (NativePNGCompressor) Compress(path string) (bool, error) {
+	if err := ReFileSizePNG(path); err != nil {
+		return false, nil //nolint:nilerr // let the pipeline try the next compressor instead of failing outright.
+	}
+
+	return true, nil
+}
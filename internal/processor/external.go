@@ -0,0 +1,62 @@
+package processor
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// ExternalAPICompressor delegates compression to an external HTTP
+// service (e.g. a Tinify-like API). It's opt-in via config since it
+// needs network access and an API key, and is meant to be placed last
+// in the pipeline behind the local backends.
+type ExternalAPICompressor struct {
+	Endpoint string
+	APIKey   string
+	Client   *http.Client
+}
+
+func (c ExternalAPICompressor) Name() string { return "external-api" }
+
+func (c ExternalAPICompressor) Compress(path string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return false, fmt.Errorf("failed to build request: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	req.Header.Set("Content-Type", "image/png")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to call external compressor: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("external compressor returned status %d", resp.StatusCode)
+	}
+
+	out, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if len(out) > sizeLimitKB*bytesInKB {
+		return false, nil
+	}
+
+	return true, writeToFile(path, out)
+}
@@ -0,0 +1,188 @@
+package processor
+
+import (
+	"context"
+
+	"github.com/trustwallet/assets-go-libs/file"
+)
+
+// Fixer is one independently composable stage of asset fixing. Chains
+// are built by decorating a BaseFixer with ChecksumFixer, LogoFixer,
+// JSONFixer, and so on, instead of hardcoding the stage list as
+// Service methods. This lets downstream users insert chain-specific
+// fixers — a Solana mint-address normalizer, a Cosmos bech32
+// re-encoder — without forking the repo, and lets each stage be tested
+// in isolation.
+type Fixer interface {
+	Fix(ctx context.Context, f *file.AssetFile) error
+}
+
+// FixerFunc adapts a plain function to the Fixer interface.
+type FixerFunc func(ctx context.Context, f *file.AssetFile) error
+
+func (fn FixerFunc) Fix(ctx context.Context, f *file.AssetFile) error {
+	return fn(ctx, f)
+}
+
+// BaseFixer is the identity Fixer at the bottom of every chain.
+type BaseFixer struct{}
+
+func (BaseFixer) Fix(context.Context, *file.AssetFile) error { return nil }
+
+// Hooks run immediately before/after the stage a decorator wraps, so a
+// caller can, say, add a validating pass after FixAssetInfo without
+// touching processor internals. A Pre error skips both the stage and
+// Post.
+type Hooks struct {
+	Pre  func(ctx context.Context, f *file.AssetFile) error
+	Post func(ctx context.Context, f *file.AssetFile) error
+}
+
+func (h Hooks) wrap(ctx context.Context, f *file.AssetFile, stage func(context.Context, *file.AssetFile) error) error {
+	if h.Pre != nil {
+		if err := h.Pre(ctx, f); err != nil {
+			return err
+		}
+	}
+
+	if err := stage(ctx, f); err != nil {
+		return err
+	}
+
+	if h.Post != nil {
+		return h.Post(ctx, f)
+	}
+
+	return nil
+}
+
+// ChecksumFixer decorates Next with Service.FixETHAddressChecksum.
+type ChecksumFixer struct {
+	Next    Fixer
+	Service *Service
+	Hooks   Hooks
+}
+
+func (c ChecksumFixer) Fix(ctx context.Context, f *file.AssetFile) error {
+	if err := c.Next.Fix(ctx, f); err != nil {
+		return err
+	}
+
+	return c.Hooks.wrap(ctx, f, func(context.Context, *file.AssetFile) error {
+		before := f.Path()
+
+		if err := c.Service.FixETHAddressChecksum(f); err != nil {
+			return err
+		}
+
+		// FixETHAddressChecksum renames f.Path() only when the
+		// directory's checksum was actually wrong; count just that,
+		// not every already-correct pass, so the counter reflects
+		// corrective actions rather than files touched.
+		if f.Path() != before {
+			checksumsFixedTotal.Inc()
+		}
+
+		return nil
+	})
+}
+
+// LogoFixer decorates Next with Service.FixLogo.
+type LogoFixer struct {
+	Next    Fixer
+	Service *Service
+	Hooks   Hooks
+}
+
+func (l LogoFixer) Fix(ctx context.Context, f *file.AssetFile) error {
+	if err := l.Next.Fix(ctx, f); err != nil {
+		return err
+	}
+
+	return l.Hooks.wrap(ctx, f, func(context.Context, *file.AssetFile) error {
+		changed, err := l.Service.FixLogo(f)
+		if err != nil {
+			return err
+		}
+
+		// FixLogo reports whether it actually resized or compressed the
+		// primary logo, so count just that instead of every already-valid
+		// pass.
+		if changed {
+			imagesCompressedTotal.Inc()
+		}
+
+		return nil
+	})
+}
+
+// JSONFixer decorates Next with Service.FixJSON.
+type JSONFixer struct {
+	Next    Fixer
+	Service *Service
+	Hooks   Hooks
+}
+
+func (j JSONFixer) Fix(ctx context.Context, f *file.AssetFile) error {
+	if err := j.Next.Fix(ctx, f); err != nil {
+		return err
+	}
+
+	return j.Hooks.wrap(ctx, f, func(context.Context, *file.AssetFile) error {
+		if err := j.Service.FixJSON(f); err != nil {
+			return err
+		}
+
+		jsonFormattedTotal.Inc()
+
+		return nil
+	})
+}
+
+// ChainInfoFixer decorates Next with Service.FixChainInfoJSON.
+type ChainInfoFixer struct {
+	Next    Fixer
+	Service *Service
+	Hooks   Hooks
+}
+
+func (c ChainInfoFixer) Fix(ctx context.Context, f *file.AssetFile) error {
+	if err := c.Next.Fix(ctx, f); err != nil {
+		return err
+	}
+
+	return c.Hooks.wrap(ctx, f, func(context.Context, *file.AssetFile) error {
+		return c.Service.FixChainInfoJSON(f)
+	})
+}
+
+// AssetInfoFixer decorates Next with Service.FixAssetInfo.
+type AssetInfoFixer struct {
+	Next    Fixer
+	Service *Service
+	Hooks   Hooks
+}
+
+func (a AssetInfoFixer) Fix(ctx context.Context, f *file.AssetFile) error {
+	if err := a.Next.Fix(ctx, f); err != nil {
+		return err
+	}
+
+	return a.Hooks.wrap(ctx, f, func(context.Context, *file.AssetFile) error {
+		return a.Service.FixAssetInfo(f)
+	})
+}
+
+// DefaultChain builds the standard checksum -> logo -> json -> chain
+// info -> asset info chain used by the cmd/ entry points, with no
+// extra hooks.
+func DefaultChain(s *Service) Fixer {
+	var f Fixer = BaseFixer{}
+	f = ChecksumFixer{Next: f, Service: s}
+	f = LogoFixer{Next: f, Service: s}
+	f = JSONFixer{Next: f, Service: s}
+	f = ChainInfoFixer{Next: f, Service: s}
+	f = AssetInfoFixer{Next: f, Service: s}
+
+	return f
+}
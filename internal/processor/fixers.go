@@ -25,68 +25,141 @@ func (s *Service) FixJSON(f *file.AssetFile) error {
 }
 
 func (s *Service) FixETHAddressChecksum(f *file.AssetFile) error {
-	if !coin.IsEVM(f.Chain().ID) {
+	mode := checksumModeFor(f.Chain().ID)
+	if mode == None {
 		return nil
 	}
 
 	assetDir := filepath.Base(f.Path())
 
-	err := validation.ValidateETHForkAddress(f.Chain(), assetDir)
+	valid, err := validChecksum(mode, f.Chain(), assetDir)
 	if err != nil {
-		checksum, e := address.EIP55Checksum(assetDir)
-		if e != nil {
-			return fmt.Errorf("failed to get checksum: %s", e)
-		}
+		return err
+	}
 
-		newName := path.GetAssetPath(f.Chain().Handle, checksum)
+	if valid {
+		return nil
+	}
 
-		if e = os.Rename(f.Path(), newName); e != nil {
-			return fmt.Errorf("failed to rename dir: %s", e)
-		}
+	checksum, err := computeChecksum(mode, f.Chain().ID, assetDir)
+	if err != nil {
+		return fmt.Errorf("failed to get checksum: %s", err)
+	}
 
-		s.fileService.UpdateFile(f, checksum)
+	newName := path.GetAssetPath(f.Chain().Handle, checksum)
 
-		log.WithField("from", assetDir).
-			WithField("to", checksum).
-			Debug("Renamed asset")
+	if err = os.Rename(f.Path(), newName); err != nil {
+		return fmt.Errorf("failed to rename dir: %s", err)
 	}
 
+	s.fileService.UpdateFile(f, checksum)
+
+	log.WithField("from", assetDir).
+		WithField("to", checksum).
+		Debug("Renamed asset")
+
 	return nil
 }
 
-func (s *Service) FixLogo(f *file.AssetFile) error {
+// validChecksum reports whether assetDir already matches the
+// checksum mode resolved for chain.
+func validChecksum(mode ChecksumMode, chain coin.Coin, assetDir string) (bool, error) {
+	if mode == EIP1191 {
+		want, err := eip1191Checksum(chain.ID, assetDir)
+		if err != nil {
+			return false, err
+		}
+
+		return want == assetDir, nil
+	}
+
+	return validation.ValidateETHForkAddress(chain, assetDir) == nil, nil
+}
+
+// computeChecksum re-checksums assetDir under the given mode.
+func computeChecksum(mode ChecksumMode, chainID uint, assetDir string) (string, error) {
+	if mode == EIP1191 {
+		return eip1191Checksum(chainID, assetDir)
+	}
+
+	return address.EIP55Checksum(assetDir)
+}
+
+// FixLogo fixes the primary logo and (re)generates its size/format
+// siblings. The returned bool reports whether the primary file itself
+// was actually resized or compressed, so callers that only care about
+// real corrections (e.g. metrics) don't have to diff file bytes to
+// find out.
+func (s *Service) FixLogo(f *file.AssetFile) (bool, error) {
 	width, height, err := image.GetPNGImageDimensions(f.Path())
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	var isLogoTooLarge bool
-	if width > MedW || height > MedH {
-		isLogoTooLarge = true
-	}
+	var changed bool
 
-	if isLogoTooLarge {
+	if width > MedW || height > MedH {
 		log.WithField("path", f.Path()).Debug("Fixing too large image")
 
 		targetW, targetH := calculateTargetDimension(width, height)
 
-		err = image.ResizePNG(f.Path(), targetW, targetH)
-		if err != nil {
-			return err
+		if err = resizeLanczos(f.Path(), targetW, targetH); err != nil {
+			return false, err
 		}
+
+		changed = true
 	}
 
-	err = validation.ValidateLogoFileSize(f.Path())
-	if err != nil { //nolint:static-check
-		// TODO: Compress images.
+	if err = validation.ValidateLogoFileSize(f.Path()); err != nil { //nolint:static-check
 		log.WithField("path", f.Path()).Debug("Fixing logo file size image")
-		err = ReFileSizePNG(f.Path())
+
+		if err = s.compressLogo(f.Path()); err != nil {
+			return false, err
+		}
+
+		changed = true
+	}
+
+	if err = s.generateLogoVariants(f); err != nil {
+		return false, err
+	}
+
+	return changed, nil
+}
+
+// compressLogo runs the configured compressor pipeline over path until
+// one of them meets the 100KB budget.
+func (s *Service) compressLogo(path string) error {
+	compressors := s.compressors
+	if len(compressors) == 0 {
+		compressors = DefaultCompressors()
+	}
+
+	for _, c := range compressors {
+		ok, err := c.Compress(path)
 		if err != nil {
 			return err
 		}
+
+		if ok {
+			log.WithField("path", path).WithField("compressor", c.Name()).Debug("Compressed logo")
+			return nil
+		}
 	}
 
-	return nil
+	return fmt.Errorf("unable to compress the image to the target size")
+}
+
+// generateLogoVariants renders the configured LogoProfile's size/format
+// siblings (logo@2x.png, logo.webp, ...) from the now-fixed primary
+// logo.
+func (s *Service) generateLogoVariants(f *file.AssetFile) error {
+	profile := s.logoProfile
+	if len(profile.Variants) == 0 {
+		profile = DefaultLogoProfile()
+	}
+
+	return GenerateVariants(f, profile)
 }
 
 const (
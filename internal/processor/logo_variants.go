@@ -0,0 +1,135 @@
+package processor
+
+import (
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chai2010/webp"
+	"github.com/gen2brain/avif"
+	"github.com/nfnt/resize"
+
+	"github.com/trustwallet/assets-go-libs/file"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// LogoVariant is one size/format rendition FixLogo produces alongside
+// the primary logo.png, e.g. a @2x retina PNG or a WebP transcode.
+type LogoVariant struct {
+	// Suffix is appended to the asset's logo stem, e.g. "@2x" for
+	// logo@2x.png, "" for the primary logo.png.
+	Suffix string
+	Ext    string
+	Width  int
+	Height int
+	// Required marks the primary variant; FixLogo fails the asset if a
+	// required variant can't be produced. Missing optional variants
+	// only log a warning.
+	Required bool
+}
+
+// LogoProfile declares the full set of variants a consumer wants out
+// of FixLogo, so different consumers (mobile wallet vs. web explorer)
+// can pick their own sizes instead of being stuck with a single
+// forced-to-256 logo.png.
+type LogoProfile struct {
+	Variants []LogoVariant
+}
+
+// DefaultLogoProfile matches the historical FixLogo behavior plus a
+// @2x retina PNG and WebP/AVIF siblings: logo.png (required),
+// logo@2x.png (256x256), logo.webp and logo.avif (128x128). The
+// 128x128 declared for logo.png is not applied to it: GenerateVariants
+// never rewrites the primary, so it keeps the size FixLogo's own resize
+// leaves (at most 256x256). Variants are rendered from that primary and
+// never upscaled, so logo@2x.png is no larger than logo.png.
+func DefaultLogoProfile() LogoProfile {
+	return LogoProfile{
+		Variants: []LogoVariant{
+			{Suffix: "", Ext: ".png", Width: MinW, Height: MinH, Required: true},
+			{Suffix: "@2x", Ext: ".png", Width: MedW, Height: MedH},
+			{Suffix: "", Ext: ".webp", Width: MinW, Height: MinH},
+			{Suffix: "", Ext: ".avif", Width: MinW, Height: MinH},
+		},
+	}
+}
+
+// GenerateVariants renders every variant in profile from the
+// already-fixed primary logo at f.Path, writing each as a sibling file
+// next to it. The primary variant (the one whose path is f.Path()
+// itself) is never (re)written here: FixLogo's resize/compress steps
+// already own that file's bytes and target dimensions, and re-applying
+// the profile's own size on top would just fight them.
+func GenerateVariants(f *file.AssetFile, profile LogoProfile) error {
+	src, err := decodePNG(f.Path())
+	if err != nil {
+		return err
+	}
+
+	for _, v := range profile.Variants {
+		out := variantPath(f.Path(), v)
+		if out == f.Path() {
+			continue
+		}
+
+		if err := writeVariant(src, v, out); err != nil {
+			if v.Required {
+				return fmt.Errorf("failed to generate required variant %q: %w", out, err)
+			}
+
+			log.WithField("path", out).WithError(err).Warn("Skipping optional logo variant")
+		}
+	}
+
+	return nil
+}
+
+func writeVariant(src image.Image, v LogoVariant, out string) error {
+	srcW, srcH := src.Bounds().Dx(), src.Bounds().Dy()
+
+	resized := src
+	switch {
+	case v.Width >= srcW && v.Height >= srcH:
+		// Never upscale: a source already at or below the variant's
+		// target size is left as-is instead of being blurred up to it.
+	case v.Width != srcW || v.Height != srcH:
+		resized = resize.Resize(uint(v.Width), uint(v.Height), src, resize.Lanczos3)
+	}
+
+	switch v.Ext {
+	case ".webp":
+		data, err := webp.EncodeRGBA(resized, 80)
+		if err != nil {
+			return fmt.Errorf("failed to encode webp: %w", err)
+		}
+
+		return writeToFile(out, data)
+	case ".avif":
+		data, err := avif.Encode(resized, avif.Quality(50))
+		if err != nil {
+			return fmt.Errorf("failed to encode avif: %w", err)
+		}
+
+		return writeToFile(out, data)
+	case ".svg":
+		// SVG variants are a pass-through: we never generate one, we
+		// only check that it's there if the profile asks for it.
+		if _, err := os.Stat(out); err != nil {
+			return fmt.Errorf("no SVG pass-through found: %w", err)
+		}
+
+		return nil
+	default:
+		return encodePNG(out, resized)
+	}
+}
+
+func variantPath(primaryPath string, v LogoVariant) string {
+	ext := filepath.Ext(primaryPath)
+	stem := strings.TrimSuffix(primaryPath, ext)
+
+	return stem + v.Suffix + v.Ext
+}
@@ -0,0 +1,156 @@
+package processor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/trustwallet/assets-go-libs/file"
+	"github.com/trustwallet/assets-go-libs/image"
+)
+
+// ManifestEntry describes one compressed logo as written to the
+// manifest: its content hash, dimensions and MIME type. The same hash
+// showing up under two {chain, asset} keys means the logo was
+// re-uploaded unchanged under a different token.
+type ManifestEntry struct {
+	SHA256 string `json:"sha256"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Bytes  int    `json:"bytes"`
+	MIME   string `json:"mime"`
+}
+
+// Manifest maps "chain/asset" to the ManifestEntry produced for its
+// logo.
+type Manifest map[string]ManifestEntry
+
+// ManifestWriter persists a Manifest to wherever a deployment wants it
+// (a local JSON file, S3, stdout) so CDN cache-busting and
+// reproducible builds don't have to care which.
+type ManifestWriter interface {
+	Write(m Manifest) error
+}
+
+// LocalManifestWriter writes the manifest as indented JSON to a file
+// on disk, e.g. assets.json at the repo root.
+type LocalManifestWriter struct {
+	Path string
+}
+
+func (w LocalManifestWriter) Write(m Manifest) error {
+	data, err := encodeManifest(m)
+	if err != nil {
+		return err
+	}
+
+	return writeToFile(w.Path, data)
+}
+
+// StdoutManifestWriter prints the manifest to stdout, useful for piping
+// into other tooling in CI.
+type StdoutManifestWriter struct{}
+
+func (StdoutManifestWriter) Write(m Manifest) error {
+	data, err := encodeManifest(m)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(data)
+
+	return err
+}
+
+func encodeManifest(m Manifest) ([]byte, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	return data, nil
+}
+
+// HashLogo computes the ManifestEntry for the already-fixed logo at
+// f.Path. It hashes the in-memory file bytes the image pipeline left
+// on disk, so the manifest always matches whatever got committed.
+func HashLogo(f *file.AssetFile) (ManifestEntry, error) {
+	data, err := os.ReadFile(f.Path())
+	if err != nil {
+		return ManifestEntry{}, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	width, height, err := image.GetPNGImageDimensions(f.Path())
+	if err != nil {
+		return ManifestEntry{}, err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return ManifestEntry{
+		SHA256: hex.EncodeToString(sum[:]),
+		Width:  width,
+		Height: height,
+		Bytes:  len(data),
+		MIME:   "image/png",
+	}, nil
+}
+
+// ManifestFixer hashes the logo once Next has finished and records the
+// result in the shared Manifest. It's meant to sit directly above
+// LogoFixer in the chain so it always sees the final compressed bytes.
+type ManifestFixer struct {
+	Next     Fixer
+	Manifest Manifest
+}
+
+func (m ManifestFixer) Fix(ctx context.Context, f *file.AssetFile) error {
+	if err := m.Next.Fix(ctx, f); err != nil {
+		return err
+	}
+
+	entry, err := HashLogo(f)
+	if err != nil {
+		return err
+	}
+
+	m.Manifest[manifestKey(f)] = entry
+
+	return nil
+}
+
+func manifestKey(f *file.AssetFile) string {
+	return f.Chain().Handle + "/" + f.Asset()
+}
+
+// VerifyManifest re-hashes every logo in files and returns an error
+// naming the first entry whose on-disk file no longer matches its
+// recorded hash. It backs --verify-manifest, which rejects PRs where
+// the manifest wasn't regenerated alongside the asset it describes.
+func VerifyManifest(m Manifest, files []*file.AssetFile) error {
+	byKey := make(map[string]*file.AssetFile, len(files))
+	for _, f := range files {
+		byKey[manifestKey(f)] = f
+	}
+
+	for key, want := range m {
+		f, ok := byKey[key]
+		if !ok {
+			return fmt.Errorf("manifest entry %q has no matching asset file", key)
+		}
+
+		got, err := HashLogo(f)
+		if err != nil {
+			return err
+		}
+
+		if got.SHA256 != want.SHA256 {
+			return fmt.Errorf("manifest hash mismatch for %q: have %s, want %s", key, got.SHA256, want.SHA256)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/trustwallet/assets-go-libs/file"
+
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	imagesCompressedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "images_compressed_total",
+		Help: "Number of logo images fixed by the processor pipeline.",
+	})
+	checksumsFixedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "checksums_fixed_total",
+		Help: "Number of asset directories renamed to a corrected checksum.",
+	})
+	jsonFormattedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "json_formatted_total",
+		Help: "Number of JSON files reformatted by the processor pipeline.",
+	})
+)
+
+// PipelineMetrics summarizes one Pipeline.Run call, returned alongside
+// the Prometheus counters so a CLI invocation can log a one-line
+// summary without scraping them.
+type PipelineMetrics struct {
+	FilesProcessed int
+	Failures       int
+	Duration       time.Duration
+}
+
+// Pipeline fans file.AssetFile inputs out across a bounded worker
+// pool instead of processing them one at a time, so a repo with
+// thousands of tokens finishes in seconds instead of minutes. Each
+// worker runs the full Fixer chain per file, so PNG decode/encode -
+// the expensive part of FixLogo - runs in parallel across CPU cores.
+type Pipeline struct {
+	Fixer Fixer
+	// Workers defaults to runtime.NumCPU() when left at zero.
+	Workers int
+	// QueueSize bounds how many files can be buffered ahead of the
+	// workers, so Run can't be made to hold an entire asset tree in
+	// memory at once. Defaults to 2x Workers when left at zero.
+	QueueSize int
+}
+
+// NewPipeline builds a Pipeline sized to the machine it runs on.
+func NewPipeline(fixer Fixer) *Pipeline {
+	workers := runtime.NumCPU()
+
+	return &Pipeline{
+		Fixer:     fixer,
+		Workers:   workers,
+		QueueSize: workers * 2,
+	}
+}
+
+// Run fixes every file in files across the worker pool, stopping early
+// once ctx is canceled so CI jobs can time-box a run instead of
+// letting it run to completion. It returns the first error
+// encountered along with metrics for however much of the run
+// completed.
+func (p *Pipeline) Run(ctx context.Context, files []*file.AssetFile) (PipelineMetrics, error) {
+	workers := p.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	queueSize := p.QueueSize
+	if queueSize <= 0 {
+		queueSize = workers * 2
+	}
+
+	start := time.Now()
+	queue := make(chan *file.AssetFile, queueSize)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		metrics  PipelineMetrics
+		firstErr error
+	)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for f := range queue {
+				if ctx.Err() != nil {
+					continue
+				}
+
+				err := p.Fixer.Fix(ctx, f)
+
+				mu.Lock()
+				metrics.FilesProcessed++
+				if err != nil {
+					metrics.Failures++
+					if firstErr == nil {
+						firstErr = fmt.Errorf("failed to fix %s: %w", f.Path(), err)
+					}
+
+					log.WithField("path", f.Path()).WithError(err).Error("Failed to process asset")
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for _, f := range files {
+		select {
+		case queue <- f:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+
+	close(queue)
+	wg.Wait()
+
+	metrics.Duration = time.Since(start)
+
+	if firstErr != nil {
+		return metrics, firstErr
+	}
+
+	return metrics, ctx.Err()
+}
@@ -0,0 +1,19 @@
+package processor
+
+import (
+	"github.com/nfnt/resize"
+)
+
+// resizeLanczos replaces image.ResizePNG's resampling with Lanczos3,
+// which preserves far more edge detail on icon-sized logos than the
+// box filter the old resizer used.
+func resizeLanczos(path string, targetW, targetH int) error {
+	src, err := decodePNG(path)
+	if err != nil {
+		return err
+	}
+
+	dst := resize.Resize(uint(targetW), uint(targetH), src, resize.Lanczos3)
+
+	return encodePNG(path, dst)
+}
@@ -0,0 +1,39 @@
+package processor
+
+import (
+	"github.com/trustwallet/assets-go-libs/file"
+)
+
+// FileService renames/tracks AssetFile paths as fixers mutate them on
+// disk. It's satisfied by the file service built in cmd/ entry points.
+type FileService interface {
+	UpdateFile(f *file.AssetFile, newName string)
+}
+
+// Service runs the Fix* passes over assets. The image-compression
+// pipeline is configured once at construction time so different asset
+// repos can pick their own quality/size tradeoffs instead of being
+// stuck with the built-in PNG re-encoder.
+type Service struct {
+	fileService FileService
+	compressors []ImageCompressor
+	logoProfile LogoProfile
+}
+
+// NewService builds a Service backed by fileService. compressors are
+// tried in order by FixLogo until the 100KB budget is met; if none are
+// given, DefaultCompressors is used. The logo variant set defaults to
+// DefaultLogoProfile and can be overridden with WithLogoProfile.
+func NewService(fileService FileService, compressors ...ImageCompressor) *Service {
+	return &Service{
+		fileService: fileService,
+		compressors: compressors,
+	}
+}
+
+// WithLogoProfile overrides the variant set FixLogo produces, e.g. a
+// smaller profile for a repo that only ships a web explorer.
+func (s *Service) WithLogoProfile(profile LogoProfile) *Service {
+	s.logoProfile = profile
+	return s
+}